@@ -39,6 +39,8 @@ const (
 	FlagSupportBundleManagerImage = "support-bundle-manager-image"
 	FlagServiceAccount            = "service-account"
 	FlagKubeConfig                = "kube-config"
+	FlagDisabledMetricsCollectors = "disabled-metrics-collectors"
+	FlagBackupMetricsMode         = "backup-metrics-mode"
 )
 
 func DaemonCmd() cli.Command {
@@ -77,6 +79,15 @@ func DaemonCmd() cli.Command {
 				Name:  FlagKubeConfig,
 				Usage: "Specify path to kube config (optional)",
 			},
+			cli.StringSliceFlag{
+				Name:  FlagDisabledMetricsCollectors,
+				Usage: "Specify metrics collectors to disable as a comma-separated list or by repeating the flag (volume, disk, backup, instance_manager, node, manager) (optional)",
+			},
+			cli.StringFlag{
+				Name:  FlagBackupMetricsMode,
+				Usage: "Specify whether backup metrics are emitted per backup (detailed) or per volume (aggregated) (optional)",
+				Value: string(metricscollector.BackupMetricsModeDetailed),
+			},
 		},
 		Action: func(c *cli.Context) {
 			if err := startManager(c); err != nil {
@@ -170,7 +181,7 @@ func startManager(c *cli.Context) error {
 
 	m := manager.NewVolumeManager(currentNodeID, clients.Datastore, proxyConnCounter)
 
-	metricscollector.InitMetricsCollectorSystem(logger, currentNodeID, clients.Datastore, kubeconfigPath, proxyConnCounter)
+	metricscollector.InitMetricsCollectorSystem(logger, currentNodeID, clients.Datastore, kubeconfigPath, proxyConnCounter, c.StringSlice(FlagDisabledMetricsCollectors), c.String(FlagBackupMetricsMode))
 
 	defaultImageSettings := map[types.SettingName]string{
 		types.SettingNameDefaultEngineImage:              engineImage,
@@ -1441,6 +1441,7 @@ func (info *ClusterInfo) collectSettings() error {
 		types.SettingNameFastReplicaRebuildEnabled:                                true,
 		types.SettingNameGuaranteedInstanceManagerCPU:                             true,
 		types.SettingNameKubernetesClusterAutoscalerEnabled:                       true,
+		types.SettingNameMaxVolumesPerNode:                                        true,
 		types.SettingNameNodeDownPodDeletionPolicy:                                true,
 		types.SettingNameNodeDrainPolicy:                                          true,
 		types.SettingNameOrphanAutoDeletion:                                       true,
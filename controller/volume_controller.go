@@ -34,6 +34,7 @@ import (
 	"github.com/longhorn/longhorn-manager/constant"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
+	volumecontroller "github.com/longhorn/longhorn-manager/metrics_collector/volume_controller"
 	"github.com/longhorn/longhorn-manager/scheduler"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
@@ -81,6 +82,8 @@ type VolumeController struct {
 	// for unit test
 	nowHandler func() string
 
+	metricsRecorder volumecontroller.MetricsRecorder
+
 	proxyConnCounter util.Counter
 }
 
@@ -114,6 +117,8 @@ func NewVolumeController(
 
 		nowHandler: util.Now,
 
+		metricsRecorder: volumecontroller.NewMetricsRecorder(),
+
 		proxyConnCounter: proxyConnCounter,
 	}
 
@@ -944,8 +949,8 @@ func (c *VolumeController) cleanupCorruptedOrStaleReplicas(v *longhorn.Volume, r
 
 		if v.Spec.BackendStoreDriver == longhorn.BackendStoreDriverTypeV1 {
 			staled := false
-			if v.Spec.StaleReplicaTimeout > 0 && util.TimestampAfterTimeout(r.Spec.FailedAt,
-				time.Duration(int64(v.Spec.StaleReplicaTimeout*60))*time.Second) {
+			if staleReplicaTimeout := types.GetStaleReplicaTimeout(v); staleReplicaTimeout > 0 &&
+				util.TimestampAfterTimeout(r.Spec.FailedAt, staleReplicaTimeout) {
 
 				staled = true
 			}
@@ -959,6 +964,9 @@ func (c *VolumeController) cleanupCorruptedOrStaleReplicas(v *longhorn.Volume, r
 				if err := c.deleteReplica(r, rs); err != nil {
 					return errors.Wrapf(err, "cannot cleanup staled replica %v", r.Name)
 				}
+				if healthyCount != 0 && staled {
+					c.metricsRecorder.IncStaleReplicasDeleted(v.Name)
+				}
 			}
 		} else {
 			if err := c.deleteReplica(r, rs); err != nil {
@@ -1068,6 +1068,90 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	s.runTestCases(c, testCases)
 }
 
+type fakeVolumeMetricsRecorder struct {
+	staleReplicasDeleted map[string]int
+}
+
+func (r *fakeVolumeMetricsRecorder) IncStaleReplicasDeleted(volumeName string) {
+	r.staleReplicasDeleted[volumeName]++
+}
+
+func (s *TestSuite) TestCleanupStaleReplicas(c *C) {
+	testCases := map[string]struct {
+		staleReplicaTimeout int
+		failedAt            string
+		expectDeleted       bool
+	}{
+		"failed replica within stale replica timeout": {
+			staleReplicaTimeout: TestVolumeStaleTimeout,
+			failedAt:            util.Now(),
+			expectDeleted:       false,
+		},
+		"failed replica after stale replica timeout": {
+			staleReplicaTimeout: TestVolumeStaleTimeout,
+			failedAt:            TestTimeNow,
+			expectDeleted:       true,
+		},
+		"zero stale replica timeout disables cleanup": {
+			staleReplicaTimeout: 0,
+			failedAt:            TestTimeNow,
+			expectDeleted:       false,
+		},
+	}
+
+	for name, tc := range testCases {
+		fmt.Printf("testing %v\n", name)
+
+		kubeClient := fake.NewSimpleClientset()
+		lhClient := lhfake.NewSimpleClientset()
+		extensionsClient := apiextensionsfake.NewSimpleClientset()
+
+		informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+		rIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Replicas().Informer().GetIndexer()
+
+		vc := newTestVolumeController(lhClient, kubeClient, extensionsClient, informerFactories, TestOwnerID1)
+		recorder := &fakeVolumeMetricsRecorder{staleReplicasDeleted: map[string]int{}}
+		vc.metricsRecorder = recorder
+
+		v := newVolume(TestVolumeName, 2)
+		v.Spec.StaleReplicaTimeout = tc.staleReplicaTimeout
+		v.Status.CurrentImage = TestEngineImage
+		e := newEngineForVolume(v)
+
+		healthyReplica := newReplicaForVolume(v, e, TestNode1, TestDiskID1)
+		healthyReplica.Spec.HealthyAt = TestTimeNow
+		failedReplica := newReplicaForVolume(v, e, TestNode2, TestDiskID1)
+		failedReplica.Spec.HealthyAt = TestTimeNow
+		failedReplica.Spec.FailedAt = tc.failedAt
+
+		rs := map[string]*longhorn.Replica{}
+		for _, r := range []*longhorn.Replica{healthyReplica, failedReplica} {
+			r, err := lhClient.LonghornV1beta2().Replicas(TestNamespace).Create(context.TODO(), r, metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = rIndexer.Add(r)
+			c.Assert(err, IsNil)
+			rs[r.Name] = r
+		}
+
+		err := vc.cleanupCorruptedOrStaleReplicas(v, rs)
+		c.Assert(err, IsNil)
+
+		_, exists := rs[failedReplica.Name]
+		c.Assert(exists, Equals, !tc.expectDeleted)
+		_, err = lhClient.LonghornV1beta2().Replicas(TestNamespace).Get(context.TODO(), failedReplica.Name, metav1.GetOptions{})
+		if tc.expectDeleted {
+			c.Assert(datastore.ErrorIsNotFound(err), Equals, true)
+			c.Assert(recorder.staleReplicasDeleted[v.Name], Equals, 1)
+		} else {
+			c.Assert(err, IsNil)
+			c.Assert(recorder.staleReplicasDeleted[v.Name], Equals, 0)
+		}
+		_, exists = rs[healthyReplica.Name]
+		c.Assert(exists, Equals, true)
+	}
+}
+
 func newVolume(name string, replicaCount int) *longhorn.Volume {
 	return &longhorn.Volume{
 		ObjectMeta: metav1.ObjectMeta{
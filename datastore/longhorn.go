@@ -1360,6 +1360,16 @@ func (s *DataStore) ListVolumeReplicas(volumeName string) (map[string]*longhorn.
 	return s.listReplicas(selector)
 }
 
+// ListVolumeReplicasRO returns a list of all Replicas with the given
+// LonghornLabelVolume name from the cache. The caller must not modify them.
+func (s *DataStore) ListVolumeReplicasRO(volumeName string) ([]*longhorn.Replica, error) {
+	selector, err := getVolumeSelector(volumeName)
+	if err != nil {
+		return nil, err
+	}
+	return s.replicaLister.Replicas(s.namespace).List(selector)
+}
+
 // ReplicaAddressToReplicaName will directly return the address if the format
 // is invalid or the replica is not found.
 func ReplicaAddressToReplicaName(address string, rs []*longhorn.Replica) string {
@@ -143,6 +143,10 @@ func (m *VolumeManager) Create(name string, spec *longhorn.VolumeSpec, recurring
 		labels[key] = types.LonghornLabelValueEnabled
 	}
 
+	if spec.StaleReplicaTimeout == 0 {
+		logrus.Warnf("Stale replica timeout of volume %v is 0, failed replicas will never be cleaned up", name)
+	}
+
 	if spec.DataSource != "" {
 		if err := m.verifyDataSourceForVolumeCreation(spec.DataSource, spec.Size); err != nil {
 			return nil, err
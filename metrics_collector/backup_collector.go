@@ -2,37 +2,73 @@ package metricscollector
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
+	"github.com/longhorn/backupstore"
+
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
+// BackupMetricsMode decides whether the backup metrics are emitted per backup
+// or aggregated per volume.
+type BackupMetricsMode string
+
+const (
+	BackupMetricsModeDetailed   = BackupMetricsMode("detailed")
+	BackupMetricsModeAggregated = BackupMetricsMode("aggregated")
+)
+
+var backupMetricsModes = []BackupMetricsMode{
+	BackupMetricsModeDetailed,
+	BackupMetricsModeAggregated,
+}
+
 type BackupCollector struct {
 	*baseCollector
 
+	mode BackupMetricsMode
+
 	sizeMetric  metricInfo
 	stateMetric metricInfo
+
+	countMetric     metricInfo
+	totalSizeMetric metricInfo
+	oldestAgeMetric metricInfo
+
+	lastCompletedTimestampMetric metricInfo
+}
+
+// volumeBackupSummary aggregates the backups of a volume. The oldest creation
+// time only accounts for completed backups.
+type volumeBackupSummary struct {
+	count     int
+	totalSize float64
+	oldest    time.Time
 }
 
 func NewBackupCollector(
 	logger logrus.FieldLogger,
 	nodeID string,
-	ds *datastore.DataStore) *BackupCollector {
+	ds *datastore.DataStore,
+	mode BackupMetricsMode) *BackupCollector {
 
 	vc := &BackupCollector{
 		baseCollector: newBaseCollector(subsystemBackup, logger, nodeID, ds),
+		mode:          mode,
 	}
 
 	vc.sizeMetric = metricInfo{
 		Desc: prometheus.NewDesc(
 			prometheus.BuildFQName(longhornName, subsystemBackup, "actual_size_bytes"),
 			"Actual size of this backup",
-			[]string{volumeLabel, backupLabel},
+			[]string{volumeLabel, backupLabel, backupTargetLabel, snapshotLabel},
 			nil,
 		),
 		Type: prometheus.GaugeValue,
@@ -42,7 +78,47 @@ func NewBackupCollector(
 		Desc: prometheus.NewDesc(
 			prometheus.BuildFQName(longhornName, subsystemBackup, "state"),
 			"State of this backup",
-			[]string{volumeLabel, backupLabel},
+			[]string{volumeLabel, backupLabel, backupTargetLabel, snapshotLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.countMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackup, "count"),
+			"Number of backups of this volume",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.totalSizeMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackup, "total_actual_size_bytes"),
+			"Total actual size of the backups of this volume",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.oldestAgeMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackup, "oldest_age_seconds"),
+			"Time in seconds since the oldest completed backup of this volume was created",
+			[]string{volumeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.lastCompletedTimestampMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemBackup, "last_completed_timestamp_seconds"),
+			"Unix time in seconds at which the newest completed backup of this volume was created",
+			[]string{volumeLabel},
 			nil,
 		),
 		Type: prometheus.GaugeValue,
@@ -52,6 +128,13 @@ func NewBackupCollector(
 }
 
 func (vc *BackupCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- vc.lastCompletedTimestampMetric.Desc
+	if vc.mode == BackupMetricsModeAggregated {
+		ch <- vc.countMetric.Desc
+		ch <- vc.totalSizeMetric.Desc
+		ch <- vc.oldestAgeMetric.Desc
+		return
+	}
 	ch <- vc.sizeMetric.Desc
 	ch <- vc.stateMetric.Desc
 }
@@ -69,20 +152,150 @@ func (vc *BackupCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
-	for _, v := range backupLists {
-		if v.Status.OwnerID == vc.currentNodeID {
-			var size float64
-			if size, err = strconv.ParseFloat(v.Status.Size, 64); err != nil {
-				vc.logger.WithError(err).Warn("Error get size")
-			}
-			backupVolumeName, ok := v.Labels[types.LonghornLabelBackupVolume]
-			if !ok {
-				vc.logger.WithError(err).Warn("Error get backup volume label")
+	if vc.mode == BackupMetricsModeAggregated {
+		vc.collectAggregatedMetrics(ch, backupLists)
+	} else {
+		for _, v := range backupLists {
+			if v.Status.OwnerID == vc.currentNodeID {
+				vc.collectBackupMetrics(ch, v)
 			}
-			ch <- prometheus.MustNewConstMetric(vc.sizeMetric.Desc, vc.sizeMetric.Type, size, backupVolumeName, v.Name)
-			ch <- prometheus.MustNewConstMetric(vc.stateMetric.Desc, vc.stateMetric.Type, float64(getBackupStateValue(v)), backupVolumeName, v.Name)
 		}
 	}
+
+	vc.collectLastCompletedTimestamps(ch, backupLists)
+}
+
+func (vc *BackupCollector) collectLastCompletedTimestamps(ch chan<- prometheus.Metric, backupLists []*longhorn.Backup) {
+	lastCompletedTimes := map[string]time.Time{}
+	for _, v := range backupLists {
+		if v.Status.OwnerID != vc.currentNodeID || v.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		backupVolumeName, ok := v.Labels[types.LonghornLabelBackupVolume]
+		if !ok {
+			continue
+		}
+		createdAt, err := util.ParseTime(v.Status.BackupCreatedAt)
+		if err != nil {
+			vc.logger.WithError(err).Warnf("Failed to parse creation time of backup %v", v.Name)
+			continue
+		}
+		if createdAt.After(lastCompletedTimes[backupVolumeName]) {
+			lastCompletedTimes[backupVolumeName] = createdAt
+		}
+	}
+
+	for backupVolumeName, lastCompletedTime := range lastCompletedTimes {
+		vc.collectLastCompletedTimestamp(ch, backupVolumeName, lastCompletedTime)
+	}
+}
+
+// collectLastCompletedTimestamp emits the last completed backup time of a single
+// volume. A panic is recovered here so that it doesn't abort the collection of
+// the others.
+func (vc *BackupCollector) collectLastCompletedTimestamp(ch chan<- prometheus.Metric, backupVolumeName string, lastCompletedTime time.Time) {
+	defer func() {
+		if err := recover(); err != nil {
+			vc.logger.WithField("error", err).Warnf("Panic during collecting last completed backup time of volume %v", backupVolumeName)
+		}
+	}()
+
+	ch <- prometheus.MustNewConstMetric(vc.lastCompletedTimestampMetric.Desc, vc.lastCompletedTimestampMetric.Type, float64(lastCompletedTime.Unix()), backupVolumeName)
+}
+
+func (vc *BackupCollector) collectAggregatedMetrics(ch chan<- prometheus.Metric, backupLists []*longhorn.Backup) {
+	summaries := map[string]*volumeBackupSummary{}
+	for _, v := range backupLists {
+		if v.Status.OwnerID != vc.currentNodeID {
+			continue
+		}
+
+		backupVolumeName, ok := v.Labels[types.LonghornLabelBackupVolume]
+		if !ok {
+			vc.logger.Warnf("Failed to get backup volume label of backup %v", v.Name)
+		}
+		summary, ok := summaries[backupVolumeName]
+		if !ok {
+			summary = &volumeBackupSummary{}
+			summaries[backupVolumeName] = summary
+		}
+
+		summary.count++
+		if size, err := strconv.ParseFloat(v.Status.Size, 64); err != nil {
+			vc.logger.WithError(err).Warnf("Failed to parse size of backup %v", v.Name)
+		} else {
+			summary.totalSize += size
+		}
+
+		if v.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		createdAt, err := util.ParseTime(v.Status.BackupCreatedAt)
+		if err != nil {
+			vc.logger.WithError(err).Warnf("Failed to parse creation time of backup %v", v.Name)
+			continue
+		}
+		if summary.oldest.IsZero() || createdAt.Before(summary.oldest) {
+			summary.oldest = createdAt
+		}
+	}
+
+	for backupVolumeName, summary := range summaries {
+		vc.collectVolumeBackupSummary(ch, backupVolumeName, summary)
+	}
+}
+
+// collectVolumeBackupSummary emits the aggregated metrics of a single volume. A
+// panic is recovered here so that it doesn't abort the collection of the others.
+func (vc *BackupCollector) collectVolumeBackupSummary(ch chan<- prometheus.Metric, backupVolumeName string, summary *volumeBackupSummary) {
+	defer func() {
+		if err := recover(); err != nil {
+			vc.logger.WithField("error", err).Warnf("Panic during collecting backup metrics of volume %v", backupVolumeName)
+		}
+	}()
+
+	ch <- prometheus.MustNewConstMetric(vc.countMetric.Desc, vc.countMetric.Type, float64(summary.count), backupVolumeName)
+	ch <- prometheus.MustNewConstMetric(vc.totalSizeMetric.Desc, vc.totalSizeMetric.Type, summary.totalSize, backupVolumeName)
+	if summary.oldest.IsZero() {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(vc.oldestAgeMetric.Desc, vc.oldestAgeMetric.Type, time.Since(summary.oldest).Seconds(), backupVolumeName)
+}
+
+// collectBackupMetrics emits the metrics of a single backup. A panic is
+// recovered here so that it doesn't abort the collection of the others.
+func (vc *BackupCollector) collectBackupMetrics(ch chan<- prometheus.Metric, v *longhorn.Backup) {
+	defer func() {
+		if err := recover(); err != nil {
+			vc.logger.WithField("error", err).Warnf("Panic during collecting metrics of backup %v", v.Name)
+		}
+	}()
+
+	backupVolumeName, ok := v.Labels[types.LonghornLabelBackupVolume]
+	if !ok {
+		vc.logger.Warnf("Failed to get backup volume label of backup %v", v.Name)
+	}
+	backupTarget := vc.getBackupTarget(v)
+	if size, err := strconv.ParseFloat(v.Status.Size, 64); err != nil {
+		vc.logger.WithError(err).Warnf("Failed to parse size of backup %v", v.Name)
+	} else {
+		ch <- prometheus.MustNewConstMetric(vc.sizeMetric.Desc, vc.sizeMetric.Type, size, backupVolumeName, v.Name, backupTarget, v.Status.SnapshotName)
+	}
+	ch <- prometheus.MustNewConstMetric(vc.stateMetric.Desc, vc.stateMetric.Type, float64(getBackupStateValue(v)), backupVolumeName, v.Name, backupTarget, v.Status.SnapshotName)
+}
+
+// getBackupTarget returns the backup target the backup is stored on, derived
+// from the backup URL. It is empty until the URL is known.
+func (vc *BackupCollector) getBackupTarget(v *longhorn.Backup) string {
+	if v.Status.URL == "" {
+		return ""
+	}
+	_, _, backupTarget, err := backupstore.DecodeBackupURL(v.Status.URL)
+	if err != nil {
+		vc.logger.WithError(err).Warnf("Failed to get backup target of backup %v", v.Name)
+		return ""
+	}
+	return backupTarget
 }
 
 func getBackupStateValue(v *longhorn.Backup) int {
@@ -0,0 +1,236 @@
+package metricscollector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/backupstore"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestBackupCollectorPartialEmission(c *C) {
+	tds := newTestDataStore()
+	for _, backup := range []*longhorn.Backup{
+		newBackup("backup-valid", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024"),
+		newBackup("backup-malformed-size", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "not-a-number"),
+		newBackup("backup-missing-volume-label", "", TestNode1, longhorn.BackupStateInProgress, "2048"),
+		newBackup("backup-other-node", TestVolumeName, TestNode2, longhorn.BackupStateCompleted, "4096"),
+	} {
+		err := tds.bIndexer.Add(backup)
+		c.Assert(err, IsNil)
+	}
+
+	bc := NewBackupCollector(logrus.StandardLogger(), TestNode1, tds.ds, BackupMetricsModeDetailed)
+	metrics := collectMetricFamilies(c, bc)
+
+	sizes := map[string]float64{}
+	for _, metric := range metrics["longhorn_backup_actual_size_bytes"].GetMetric() {
+		labels := getMetricLabels(metric)
+		sizes[labels[backupLabel]] = metric.GetGauge().GetValue()
+		if labels[backupLabel] == "backup-missing-volume-label" {
+			c.Assert(labels[volumeLabel], Equals, "")
+		}
+	}
+	c.Assert(sizes, DeepEquals, map[string]float64{
+		"backup-valid":                1024,
+		"backup-missing-volume-label": 2048,
+	})
+
+	states := map[string]float64{}
+	for _, metric := range metrics["longhorn_backup_state"].GetMetric() {
+		states[getMetricLabels(metric)[backupLabel]] = metric.GetGauge().GetValue()
+	}
+	c.Assert(states, DeepEquals, map[string]float64{
+		"backup-valid":                3,
+		"backup-malformed-size":       3,
+		"backup-missing-volume-label": 2,
+	})
+}
+
+func (s *TestSuite) TestBackupCollectorIsolatesMalformedBackup(c *C) {
+	tds := newTestDataStore()
+	createdAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	for _, backup := range []*longhorn.Backup{
+		newBackup("backup-1", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024"),
+		// An invalid UTF-8 label value makes emitting the metrics of this backup panic
+		newBackup("backup-2", "\xff", TestNode1, longhorn.BackupStateCompleted, "1024"),
+		newBackup("backup-3", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024"),
+	} {
+		// Completed backups with a creation time also reach the last completed
+		// timestamp, which must not abort the collection either
+		backup.Status.BackupCreatedAt = createdAt
+		err := tds.bIndexer.Add(backup)
+		c.Assert(err, IsNil)
+	}
+
+	for _, mode := range backupMetricsModes {
+		bc := NewBackupCollector(logrus.StandardLogger(), TestNode1, tds.ds, mode)
+		// The backups and volumes are iterated in random order, collect several
+		// times so that the malformed one doesn't always come last
+		for i := 0; i < 10; i++ {
+			metrics := collectMetricFamilies(c, bc)
+
+			if mode == BackupMetricsModeDetailed {
+				backups := map[string]bool{}
+				for _, metric := range metrics["longhorn_backup_state"].GetMetric() {
+					backups[getMetricLabels(metric)[backupLabel]] = true
+				}
+				c.Assert(backups, DeepEquals, map[string]bool{
+					"backup-1": true,
+					"backup-3": true,
+				})
+			} else {
+				counts := map[string]float64{}
+				for _, metric := range metrics["longhorn_backup_count"].GetMetric() {
+					counts[getMetricLabels(metric)[volumeLabel]] = metric.GetGauge().GetValue()
+				}
+				c.Assert(counts, DeepEquals, map[string]float64{TestVolumeName: 2})
+			}
+
+			volumes := map[string]bool{}
+			for _, metric := range metrics["longhorn_backup_last_completed_timestamp_seconds"].GetMetric() {
+				volumes[getMetricLabels(metric)[volumeLabel]] = true
+			}
+			c.Assert(volumes, DeepEquals, map[string]bool{TestVolumeName: true})
+		}
+	}
+}
+
+func (s *TestSuite) TestBackupCollectorModeCardinality(c *C) {
+	tds := newTestDataStore()
+
+	backupCount := 100
+	now := time.Now()
+	for i := 0; i < backupCount; i++ {
+		backup := newBackup(fmt.Sprintf("backup-%d", i), TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024")
+		backup.Status.BackupCreatedAt = now.Add(-time.Duration(i+1) * time.Hour).UTC().Format(time.RFC3339)
+		err := tds.bIndexer.Add(backup)
+		c.Assert(err, IsNil)
+	}
+	for _, backup := range []*longhorn.Backup{
+		newBackup("backup-in-progress", TestVolumeName, TestNode1, longhorn.BackupStateInProgress, "512"),
+		newBackup("backup-other-volume", "other-volume", TestNode1, longhorn.BackupStateInProgress, "2048"),
+		newBackup("backup-other-node", TestVolumeName, TestNode2, longhorn.BackupStateCompleted, "4096"),
+	} {
+		err := tds.bIndexer.Add(backup)
+		c.Assert(err, IsNil)
+	}
+
+	metrics := collectMetricFamilies(c, NewBackupCollector(logrus.StandardLogger(), TestNode1, tds.ds, BackupMetricsModeDetailed))
+	c.Assert(metrics["longhorn_backup_state"].GetMetric(), HasLen, backupCount+2)
+	c.Assert(metrics["longhorn_backup_actual_size_bytes"].GetMetric(), HasLen, backupCount+2)
+	_, exists := metrics["longhorn_backup_count"]
+	c.Assert(exists, Equals, false)
+
+	metrics = collectMetricFamilies(c, NewBackupCollector(logrus.StandardLogger(), TestNode1, tds.ds, BackupMetricsModeAggregated))
+	_, exists = metrics["longhorn_backup_state"]
+	c.Assert(exists, Equals, false)
+	_, exists = metrics["longhorn_backup_actual_size_bytes"]
+	c.Assert(exists, Equals, false)
+
+	getValues := func(name string) map[string]float64 {
+		values := map[string]float64{}
+		for _, metric := range metrics[name].GetMetric() {
+			values[getMetricLabels(metric)[volumeLabel]] = metric.GetGauge().GetValue()
+		}
+		return values
+	}
+	c.Assert(getValues("longhorn_backup_count"), DeepEquals, map[string]float64{
+		TestVolumeName: float64(backupCount + 1),
+		"other-volume": 1,
+	})
+	c.Assert(getValues("longhorn_backup_total_actual_size_bytes"), DeepEquals, map[string]float64{
+		TestVolumeName: float64(backupCount*1024 + 512),
+		"other-volume": 2048,
+	})
+
+	// The volume without completed backups has no age metric
+	oldestAges := getValues("longhorn_backup_oldest_age_seconds")
+	c.Assert(oldestAges, HasLen, 1)
+	c.Assert(oldestAges[TestVolumeName] >= (time.Duration(backupCount)*time.Hour).Seconds(), Equals, true)
+	// The newest completed backup is covered by the last completed timestamp
+	_, exists = metrics["longhorn_backup_newest_age_seconds"]
+	c.Assert(exists, Equals, false)
+	c.Assert(getValues("longhorn_backup_last_completed_timestamp_seconds"), HasLen, 1)
+}
+
+func (s *TestSuite) TestBackupCollectorTargetAndSnapshotLabels(c *C) {
+	tds := newTestDataStore()
+
+	backupTarget := "s3://backupbucket@us-east-1/backupstore"
+	completedBackup := newBackup("backup-completed", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024")
+	completedBackup.Status.SnapshotName = "snapshot-1"
+	completedBackup.Status.URL = backupstore.EncodeBackupURL(completedBackup.Name, TestVolumeName, backupTarget)
+	unstartedBackup := newBackup("backup-new", TestVolumeName, TestNode1, longhorn.BackupStateNew, "0")
+	for _, backup := range []*longhorn.Backup{completedBackup, unstartedBackup} {
+		err := tds.bIndexer.Add(backup)
+		c.Assert(err, IsNil)
+	}
+
+	bc := NewBackupCollector(logrus.StandardLogger(), TestNode1, tds.ds, BackupMetricsModeDetailed)
+	metrics := collectMetricFamilies(c, bc)
+
+	expectedLabels := map[string]map[string]string{
+		completedBackup.Name: {
+			volumeLabel:       TestVolumeName,
+			backupLabel:       completedBackup.Name,
+			backupTargetLabel: backupTarget,
+			snapshotLabel:     "snapshot-1",
+		},
+		// Backups whose URL and snapshot are unknown yet get empty labels
+		unstartedBackup.Name: {
+			volumeLabel:       TestVolumeName,
+			backupLabel:       unstartedBackup.Name,
+			backupTargetLabel: "",
+			snapshotLabel:     "",
+		},
+	}
+	for _, name := range []string{"longhorn_backup_actual_size_bytes", "longhorn_backup_state"} {
+		labels := map[string]map[string]string{}
+		for _, metric := range metrics[name].GetMetric() {
+			metricLabels := getMetricLabels(metric)
+			labels[metricLabels[backupLabel]] = metricLabels
+		}
+		c.Assert(labels, DeepEquals, expectedLabels, Commentf("metric %v", name))
+	}
+}
+
+func (s *TestSuite) TestBackupCollectorLastCompletedTimestamp(c *C) {
+	tds := newTestDataStore()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	newBackupCreatedAt := func(name, volumeName string, state longhorn.BackupState, createdAt string) *longhorn.Backup {
+		backup := newBackup(name, volumeName, TestNode1, state, "1024")
+		backup.Status.BackupCreatedAt = createdAt
+		return backup
+	}
+	for _, backup := range []*longhorn.Backup{
+		newBackupCreatedAt("backup-old", TestVolumeName, longhorn.BackupStateCompleted, now.Add(-2*time.Hour).Format(time.RFC3339)),
+		newBackupCreatedAt("backup-newest", TestVolumeName, longhorn.BackupStateCompleted, now.Add(-time.Hour).Format(time.RFC3339)),
+		newBackupCreatedAt("backup-malformed-time", TestVolumeName, longhorn.BackupStateCompleted, "not-a-time"),
+		// Backups in progress don't update the gauge even if they carry a newer time
+		newBackupCreatedAt("backup-in-progress", TestVolumeName, longhorn.BackupStateInProgress, now.Format(time.RFC3339)),
+		newBackupCreatedAt("backup-in-progress-only", "in-progress-volume", longhorn.BackupStateInProgress, now.Format(time.RFC3339)),
+	} {
+		err := tds.bIndexer.Add(backup)
+		c.Assert(err, IsNil)
+	}
+
+	for _, mode := range backupMetricsModes {
+		bc := NewBackupCollector(logrus.StandardLogger(), TestNode1, tds.ds, mode)
+		metrics := collectMetricFamilies(c, bc)
+
+		timestamps := map[string]float64{}
+		for _, metric := range metrics["longhorn_backup_last_completed_timestamp_seconds"].GetMetric() {
+			timestamps[getMetricLabels(metric)[volumeLabel]] = metric.GetGauge().GetValue()
+		}
+		c.Assert(timestamps, DeepEquals, map[string]float64{
+			TestVolumeName: float64(now.Add(-time.Hour).Unix()),
+		}, Commentf("mode %v", mode))
+	}
+}
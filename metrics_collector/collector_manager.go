@@ -0,0 +1,112 @@
+package metricscollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+)
+
+// collectorNames lists every collector that can be enabled or disabled by name
+var collectorNames = []string{
+	subsystemVolume,
+	subsystemDisk,
+	subsystemBackup,
+	subsystemInstanceManager,
+	subsystemNode,
+	subsystemManager,
+}
+
+// CollectorManager creates the Longhorn metrics collectors for the current node
+// and registers the enabled ones with a Prometheus registerer.
+type CollectorManager struct {
+	logger        logrus.FieldLogger
+	currentNodeID string
+	ds            *datastore.DataStore
+	registerer    prometheus.Registerer
+
+	disabled map[string]bool
+
+	backupMetricsMode BackupMetricsMode
+}
+
+func NewCollectorManager(
+	logger logrus.FieldLogger,
+	nodeID string,
+	ds *datastore.DataStore,
+	registerer prometheus.Registerer) *CollectorManager {
+
+	return &CollectorManager{
+		logger:        logger,
+		currentNodeID: nodeID,
+		ds:            ds,
+		registerer:    registerer,
+
+		disabled: map[string]bool{},
+
+		backupMetricsMode: BackupMetricsModeDetailed,
+	}
+}
+
+// SetEnabled enables or disables the collector with the given name. It must be
+// called before the collectors are registered.
+func (cm *CollectorManager) SetEnabled(name string, enabled bool) {
+	if !util.Contains(collectorNames, name) {
+		cm.logger.Warnf("Unknown metrics collector %v", name)
+		return
+	}
+	cm.disabled[name] = !enabled
+}
+
+func (cm *CollectorManager) IsEnabled(name string) bool {
+	return !cm.disabled[name]
+}
+
+// SetBackupMetricsMode sets the mode of the backup collector. It must be called
+// before the collectors are registered.
+func (cm *CollectorManager) SetBackupMetricsMode(mode BackupMetricsMode) {
+	for _, m := range backupMetricsModes {
+		if m == mode {
+			cm.backupMetricsMode = mode
+			return
+		}
+	}
+	cm.logger.Warnf("Unknown backup metrics mode %v, using %v", mode, cm.backupMetricsMode)
+}
+
+// RegisterDatastoreCollectors registers the collectors that only rely on the
+// Longhorn datastore.
+func (cm *CollectorManager) RegisterDatastoreCollectors() {
+	if cm.IsEnabled(subsystemVolume) {
+		cm.register(subsystemVolume, NewVolumeCollector(cm.logger, cm.currentNodeID, cm.ds))
+	}
+	if cm.IsEnabled(subsystemDisk) {
+		cm.register(subsystemDisk, NewDiskCollector(cm.logger, cm.currentNodeID, cm.ds))
+	}
+	if cm.IsEnabled(subsystemBackup) {
+		cm.register(subsystemBackup, NewBackupCollector(cm.logger, cm.currentNodeID, cm.ds, cm.backupMetricsMode))
+	}
+}
+
+// RegisterKubeMetricsCollectors registers the collectors that additionally
+// rely on the Kubernetes metrics server.
+func (cm *CollectorManager) RegisterKubeMetricsCollectors(kubeMetricsClient *metricsclientset.Clientset, namespace string, proxyConnCounter util.Counter) {
+	if cm.IsEnabled(subsystemInstanceManager) {
+		cm.register(subsystemInstanceManager, NewInstanceManagerCollector(cm.logger, cm.currentNodeID, cm.ds, proxyConnCounter, kubeMetricsClient, namespace))
+	}
+	if cm.IsEnabled(subsystemNode) {
+		cm.register(subsystemNode, NewNodeCollector(cm.logger, cm.currentNodeID, cm.ds, kubeMetricsClient))
+	}
+	if cm.IsEnabled(subsystemManager) {
+		cm.register(subsystemManager, NewManagerCollector(cm.logger, cm.currentNodeID, cm.ds, kubeMetricsClient, namespace))
+	}
+}
+
+func (cm *CollectorManager) register(name string, collector prometheus.Collector) {
+	if err := cm.registerer.Register(collector); err != nil {
+		cm.logger.WithField("collector", name).WithError(err).Warn("Failed to register collector")
+	}
+}
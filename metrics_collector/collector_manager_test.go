@@ -0,0 +1,65 @@
+package metricscollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestCollectorManagerDisableCollector(c *C) {
+	tds := newTestDataStore()
+	err := tds.bIndexer.Add(newBackup("backup-1", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024"))
+	c.Assert(err, IsNil)
+
+	logger := logrus.StandardLogger()
+
+	registry := prometheus.NewRegistry()
+	cm := NewCollectorManager(logger, TestNode1, tds.ds, registry)
+	cm.RegisterDatastoreCollectors()
+	metrics := gatherMetricFamilies(c, registry)
+	c.Assert(metrics["longhorn_backup_state"].GetMetric(), HasLen, 1)
+	c.Assert(metrics["longhorn_backup_actual_size_bytes"].GetMetric(), HasLen, 1)
+
+	registry = prometheus.NewRegistry()
+	cm = NewCollectorManager(logger, TestNode1, tds.ds, registry)
+	cm.SetEnabled(subsystemBackup, false)
+	c.Assert(cm.IsEnabled(subsystemBackup), Equals, false)
+	c.Assert(cm.IsEnabled(subsystemVolume), Equals, true)
+	cm.RegisterDatastoreCollectors()
+	metrics = gatherMetricFamilies(c, registry)
+	_, exists := metrics["longhorn_backup_state"]
+	c.Assert(exists, Equals, false)
+	_, exists = metrics["longhorn_backup_actual_size_bytes"]
+	c.Assert(exists, Equals, false)
+
+	// Unknown collector names are ignored
+	cm.SetEnabled("unknown", false)
+	c.Assert(cm.IsEnabled("unknown"), Equals, true)
+}
+
+func (s *TestSuite) TestSplitCollectorNames(c *C) {
+	// The flag can be repeated or list several names separated by commas
+	c.Assert(splitCollectorNames([]string{"volume,disk", " backup ", "node,,"}), DeepEquals,
+		[]string{subsystemVolume, subsystemDisk, subsystemBackup, subsystemNode})
+	c.Assert(splitCollectorNames(nil), DeepEquals, []string{})
+}
+
+func (s *TestSuite) TestCollectorManagerBackupMetricsMode(c *C) {
+	tds := newTestDataStore()
+	err := tds.bIndexer.Add(newBackup("backup-1", TestVolumeName, TestNode1, longhorn.BackupStateCompleted, "1024"))
+	c.Assert(err, IsNil)
+
+	registry := prometheus.NewRegistry()
+	cm := NewCollectorManager(logrus.StandardLogger(), TestNode1, tds.ds, registry)
+	cm.SetBackupMetricsMode(BackupMetricsModeAggregated)
+	// Unknown modes are ignored
+	cm.SetBackupMetricsMode("unknown")
+	cm.RegisterDatastoreCollectors()
+	metrics := gatherMetricFamilies(c, registry)
+	c.Assert(metrics["longhorn_backup_count"].GetMetric(), HasLen, 1)
+	_, exists := metrics["longhorn_backup_state"]
+	c.Assert(exists, Equals, false)
+}
@@ -2,6 +2,7 @@ package metricscollector
 
 import (
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -20,24 +21,18 @@ import (
 	_ "github.com/longhorn/longhorn-manager/metrics_collector/workqueue"        // load the workqueue metrics
 )
 
-func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string, ds *datastore.DataStore, kubeconfigPath string, proxyConnCounter util.Counter) {
+func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string, ds *datastore.DataStore, kubeconfigPath string, proxyConnCounter util.Counter, disabledCollectors []string, backupMetricsMode string) {
 	logger.Info("Initializing metrics collector system")
 
-	vc := NewVolumeCollector(logger, currentNodeID, ds)
-	dc := NewDiskCollector(logger, currentNodeID, ds)
-	bc := NewBackupCollector(logger, currentNodeID, ds)
-
-	if err := registry.Register(vc); err != nil {
-		logger.WithField("collector", subsystemVolume).WithError(err).Warn("Failed to register collector")
+	cm := NewCollectorManager(logger, currentNodeID, ds, registry.Registerer())
+	for _, name := range splitCollectorNames(disabledCollectors) {
+		cm.SetEnabled(name, false)
 	}
-
-	if err := registry.Register(dc); err != nil {
-		logger.WithField("collector", subsystemDisk).WithError(err).Warn("Failed to register collector")
+	if backupMetricsMode != "" {
+		cm.SetBackupMetricsMode(BackupMetricsMode(backupMetricsMode))
 	}
 
-	if err := registry.Register(bc); err != nil {
-		logger.WithField("collector", subsystemBackup).WithError(err).Warn("Failed to register collector")
-	}
+	cm.RegisterDatastoreCollectors()
 
 	namespace := os.Getenv(types.EnvPodNamespace)
 	if namespace == "" {
@@ -49,21 +44,23 @@ func InitMetricsCollectorSystem(logger logrus.FieldLogger, currentNodeID string,
 	if kubeMetricsClient, err := buildMetricClientFromConfigPath(kubeconfigPath); err != nil {
 		logger.WithError(err).Warn("Skipped instantiating InstanceManagerCollector, ManagerCollector, and NodeCollector")
 	} else {
-		imc := NewInstanceManagerCollector(logger, currentNodeID, ds, proxyConnCounter, kubeMetricsClient, namespace)
-		nc := NewNodeCollector(logger, currentNodeID, ds, kubeMetricsClient)
-		mc := NewManagerCollector(logger, currentNodeID, ds, kubeMetricsClient, namespace)
+		cm.RegisterKubeMetricsCollectors(kubeMetricsClient, namespace, proxyConnCounter)
+	}
 
-		if err := registry.Register(imc); err != nil {
-			logger.WithField("collector", subsystemInstanceManager).WithError(err).Warn("Failed to register collector")
-		}
-		if err := registry.Register(nc); err != nil {
-			logger.WithField("collector", subsystemNode).WithError(err).Warn("Failed to register collector")
-		}
-		if err := registry.Register(mc); err != nil {
-			logger.WithField("collector", subsystemManager).WithError(err).Warn("Failed to register collector")
+}
+
+// splitCollectorNames returns the collector names of the given flag values. A
+// value can list several names separated by commas.
+func splitCollectorNames(values []string) []string {
+	names := []string{}
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
 		}
 	}
-
+	return names
 }
 
 func buildMetricClientFromConfigPath(kubeconfigPath string) (*metricsclientset.Clientset, error) {
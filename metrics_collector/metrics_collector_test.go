@@ -0,0 +1,180 @@
+package metricscollector
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+const (
+	TestNamespace = "default"
+	TestNode1     = "test-node-name-1"
+	TestNode2     = "test-node-name-2"
+
+	TestVolumeName = "test-volume"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct {
+}
+
+var _ = Suite(&TestSuite{})
+
+func (s *TestSuite) SetUpTest(c *C) {
+	logrus.SetLevel(logrus.DebugLevel)
+}
+
+type testDataStore struct {
+	ds *datastore.DataStore
+
+	bIndexer cache.Indexer
+	eIndexer cache.Indexer
+	rIndexer cache.Indexer
+	vIndexer cache.Indexer
+}
+
+func newTestDataStore() *testDataStore {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+	return &testDataStore{
+		ds: datastore.NewDataStore(TestNamespace, lhClient, kubeClient, extensionsClient, informerFactories),
+
+		bIndexer: informerFactories.LhInformerFactory.Longhorn().V1beta2().Backups().Informer().GetIndexer(),
+		eIndexer: informerFactories.LhInformerFactory.Longhorn().V1beta2().Engines().Informer().GetIndexer(),
+		rIndexer: informerFactories.LhInformerFactory.Longhorn().V1beta2().Replicas().Informer().GetIndexer(),
+		vIndexer: informerFactories.LhInformerFactory.Longhorn().V1beta2().Volumes().Informer().GetIndexer(),
+	}
+}
+
+func newBackup(name, volumeName, ownerID string, state longhorn.BackupState, size string) *longhorn.Backup {
+	labels := map[string]string{}
+	if volumeName != "" {
+		labels[types.LonghornLabelBackupVolume] = volumeName
+	}
+	return &longhorn.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: TestNamespace,
+			Labels:    labels,
+		},
+		Status: longhorn.BackupStatus{
+			OwnerID:    ownerID,
+			State:      state,
+			Size:       size,
+			VolumeName: volumeName,
+		},
+	}
+}
+
+func newVolume(name, ownerID string) *longhorn.Volume {
+	return &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: TestNamespace,
+		},
+		Spec: longhorn.VolumeSpec{
+			NumberOfReplicas: 3,
+			Size:             1073741824,
+		},
+		Status: longhorn.VolumeStatus{
+			OwnerID:    ownerID,
+			State:      longhorn.VolumeStateAttached,
+			Robustness: longhorn.VolumeRobustnessHealthy,
+		},
+	}
+}
+
+func newEngineForVolume(v *longhorn.Volume) *longhorn.Engine {
+	return &longhorn.Engine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v.Name + "-e-0",
+			Namespace: TestNamespace,
+			Labels:    types.GetVolumeLabels(v.Name),
+		},
+		Spec: longhorn.EngineSpec{
+			InstanceSpec: longhorn.InstanceSpec{
+				VolumeName: v.Name,
+				NodeID:     v.Status.OwnerID,
+			},
+		},
+	}
+}
+
+func newReplicaForVolume(v *longhorn.Volume, name, healthyAt, failedAt string) *longhorn.Replica {
+	return &longhorn.Replica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: TestNamespace,
+			Labels:    types.GetVolumeLabels(v.Name),
+		},
+		Spec: longhorn.ReplicaSpec{
+			InstanceSpec: longhorn.InstanceSpec{
+				VolumeName: v.Name,
+			},
+			HealthyAt: healthyAt,
+			FailedAt:  failedAt,
+		},
+	}
+}
+
+// addVolume adds the volume and its engine to the datastore
+func (tds *testDataStore) addVolume(c *C, v *longhorn.Volume) {
+	err := tds.vIndexer.Add(v)
+	c.Assert(err, IsNil)
+	err = tds.eIndexer.Add(newEngineForVolume(v))
+	c.Assert(err, IsNil)
+}
+
+// gatherMetricFamilies returns the metric families gathered by the registry,
+// keyed by metric name.
+func gatherMetricFamilies(c *C, registry *prometheus.Registry) map[string]*dto.MetricFamily {
+	families, err := registry.Gather()
+	c.Assert(err, IsNil)
+
+	metricFamilies := map[string]*dto.MetricFamily{}
+	for _, family := range families {
+		metricFamilies[family.GetName()] = family
+	}
+	return metricFamilies
+}
+
+// collectMetricFamilies registers the collector with a new registry and
+// returns the gathered metric families keyed by metric name.
+func collectMetricFamilies(c *C, collector prometheus.Collector) map[string]*dto.MetricFamily {
+	registry := prometheus.NewRegistry()
+	err := registry.Register(collector)
+	c.Assert(err, IsNil)
+	return gatherMetricFamilies(c, registry)
+}
+
+func getMetricLabels(metric *dto.Metric) map[string]string {
+	labels := map[string]string{}
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	return labels
+}
@@ -30,6 +30,7 @@ type NodeCollector struct {
 	storageCapacityMetric    metricInfo
 	storageUsageMetric       metricInfo
 	storageReservationMetric metricInfo
+	volumeCountMetric        metricInfo
 }
 
 func NewNodeCollector(
@@ -133,6 +134,16 @@ func NewNodeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	nc.volumeCountMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemNode, "volume_count"),
+			"The number of distinct volumes having replicas on this node",
+			[]string{nodeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	return nc
 }
 
@@ -146,6 +157,7 @@ func (nc *NodeCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- nc.storageCapacityMetric.Desc
 	ch <- nc.storageUsageMetric.Desc
 	ch <- nc.storageReservationMetric.Desc
+	ch <- nc.volumeCountMetric.Desc
 }
 
 func (nc *NodeCollector) Collect(ch chan<- prometheus.Metric) {
@@ -181,6 +193,12 @@ func (nc *NodeCollector) Collect(ch chan<- prometheus.Metric) {
 		nc.collectNodeStorage(ch)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		nc.collectNodeVolumeCount(ch)
+	}()
+
 	wg.Wait()
 }
 
@@ -309,3 +327,24 @@ func (nc *NodeCollector) collectNodeStorage(ch chan<- prometheus.Metric) {
 	ch <- prometheus.MustNewConstMetric(nc.storageUsageMetric.Desc, nc.storageUsageMetric.Type, float64(storageUsage), nc.currentNodeID)
 	ch <- prometheus.MustNewConstMetric(nc.storageReservationMetric.Desc, nc.storageReservationMetric.Type, float64(storageReservation), nc.currentNodeID)
 }
+
+func (nc *NodeCollector) collectNodeVolumeCount(ch chan<- prometheus.Metric) {
+	defer func() {
+		if err := recover(); err != nil {
+			nc.logger.WithField("error", err).Warn("Panic during collecting metrics")
+		}
+	}()
+
+	replicas, err := nc.ds.ListReplicasByNodeRO(nc.currentNodeID)
+	if err != nil {
+		nc.logger.WithError(err).Warn("Error during scrape")
+		return
+	}
+
+	// Several replicas of a volume on this node count once
+	volumes := map[string]struct{}{}
+	for _, r := range replicas {
+		volumes[r.Spec.VolumeName] = struct{}{}
+	}
+	ch <- prometheus.MustNewConstMetric(nc.volumeCountMetric.Desc, nc.volumeCountMetric.Type, float64(len(volumes)), nc.currentNodeID)
+}
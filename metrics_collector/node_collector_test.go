@@ -0,0 +1,51 @@
+package metricscollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestNodeCollectorVolumeCount(c *C) {
+	tds := newTestDataStore()
+
+	for name, placement := range map[string]struct {
+		volumeName string
+		nodeID     string
+	}{
+		"replica-1": {TestVolumeName, TestNode1},
+		"replica-2": {TestVolumeName, TestNode1},
+		"replica-3": {"other-volume", TestNode1},
+		"replica-4": {"other-volume", TestNode2},
+		"replica-5": {"volume-on-other-node", TestNode2},
+	} {
+		r := newReplicaForVolume(newVolume(placement.volumeName, placement.nodeID), name, "", "")
+		r.Spec.NodeID = placement.nodeID
+		r.Labels[types.LonghornNodeKey] = placement.nodeID
+		err := tds.rIndexer.Add(r)
+		c.Assert(err, IsNil)
+	}
+
+	// The other collections need a kube metrics client, only collect the volume count
+	nc := NewNodeCollector(logrus.StandardLogger(), TestNode1, tds.ds, nil)
+	ch := make(chan prometheus.Metric, 1)
+	nc.collectNodeVolumeCount(ch)
+	close(ch)
+
+	metrics := []*dto.Metric{}
+	for m := range ch {
+		metric := &dto.Metric{}
+		err := m.Write(metric)
+		c.Assert(err, IsNil)
+		metrics = append(metrics, metric)
+	}
+	c.Assert(metrics, HasLen, 1)
+	c.Assert(getMetricLabels(metrics[0])[nodeLabel], Equals, TestNode1)
+	// The two replicas of the volume on this node count once
+	c.Assert(metrics[0].GetGauge().GetValue(), Equals, float64(2))
+}
@@ -16,6 +16,11 @@ func Register(collector prometheus.Collector) error {
 	return longhornCustomRegistry.Register(collector)
 }
 
+// Registerer returns the longhornCustomRegistry as a prometheus.Registerer
+func Registerer() prometheus.Registerer {
+	return longhornCustomRegistry
+}
+
 // Handler returns an http.Handler for longhornCustomRegistry, using default HandlerOpts
 func Handler() http.Handler {
 	return promhttp.HandlerFor(longhornCustomRegistry, promhttp.HandlerOpts{})
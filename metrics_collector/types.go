@@ -25,6 +25,8 @@ const (
 	instanceManagerType  = "instance_manager_type"
 	managerLabel         = "manager"
 	backupLabel          = "backup"
+	backupTargetLabel    = "backup_target"
+	snapshotLabel        = "snapshot"
 	pvcLabel             = "pvc"
 )
 
@@ -1,6 +1,8 @@
 package metricscollector
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
@@ -24,6 +26,11 @@ type VolumeCollector struct {
 	stateMetric      metricInfo
 	robustnessMetric metricInfo
 
+	lastBackupAgeMetric metricInfo
+
+	replicaCountMetric        metricInfo
+	healthyReplicaCountMetric metricInfo
+
 	volumePerfMetrics
 }
 
@@ -88,6 +95,36 @@ func NewVolumeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	vc.lastBackupAgeMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "last_backup_age_seconds"),
+			"Time in seconds since the last backup of this volume was created",
+			[]string{nodeLabel, volumeLabel, pvcLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.replicaCountMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "replica_count"),
+			"Number of replicas of this volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.healthyReplicaCountMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "healthy_replica_count"),
+			"Number of healthy replicas of this volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	vc.volumePerfMetrics.throughputMetrics.read = metricInfo{
 		Desc: prometheus.NewDesc(
 			prometheus.BuildFQName(longhornName, subsystemVolume, "read_throughput"),
@@ -156,6 +193,9 @@ func (vc *VolumeCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- vc.sizeMetric.Desc
 	ch <- vc.stateMetric.Desc
 	ch <- vc.robustnessMetric.Desc
+	ch <- vc.lastBackupAgeMetric.Desc
+	ch <- vc.replicaCountMetric.Desc
+	ch <- vc.healthyReplicaCountMetric.Desc
 }
 
 func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
@@ -199,6 +239,8 @@ func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
 			ch <- prometheus.MustNewConstMetric(vc.sizeMetric.Desc, vc.sizeMetric.Type, float64(v.Status.ActualSize), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
 			ch <- prometheus.MustNewConstMetric(vc.stateMetric.Desc, vc.stateMetric.Type, float64(getVolumeStateValue(v)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
 			ch <- prometheus.MustNewConstMetric(vc.robustnessMetric.Desc, vc.robustnessMetric.Type, float64(getVolumeRobustnessValue(v)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
+			vc.collectLastBackupAge(ch, v)
+			vc.collectReplicaCount(ch, v)
 			ch <- prometheus.MustNewConstMetric(vc.volumePerfMetrics.throughputMetrics.read.Desc, vc.volumePerfMetrics.throughputMetrics.read.Type, float64(vc.getVolumeReadThroughput(metrics)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
 			ch <- prometheus.MustNewConstMetric(vc.volumePerfMetrics.throughputMetrics.write.Desc, vc.volumePerfMetrics.throughputMetrics.write.Type, float64(vc.getVolumeWriteThroughput(metrics)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
 			ch <- prometheus.MustNewConstMetric(vc.volumePerfMetrics.iopsMetrics.read.Desc, vc.volumePerfMetrics.iopsMetrics.read.Type, float64(vc.getVolumeReadIOPS(metrics)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
@@ -209,6 +251,34 @@ func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
 	}
 }
 
+func (vc *VolumeCollector) collectLastBackupAge(ch chan<- prometheus.Metric, v *longhorn.Volume) {
+	if v.Status.LastBackupAt == "" {
+		return
+	}
+	lastBackupAt, err := util.ParseTime(v.Status.LastBackupAt)
+	if err != nil {
+		vc.logger.WithError(err).Warnf("Failed to parse last backup time of volume %v", v.Name)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(vc.lastBackupAgeMetric.Desc, vc.lastBackupAgeMetric.Type, time.Since(lastBackupAt).Seconds(), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
+}
+
+func (vc *VolumeCollector) collectReplicaCount(ch chan<- prometheus.Metric, v *longhorn.Volume) {
+	replicas, err := vc.ds.ListVolumeReplicasRO(v.Name)
+	if err != nil {
+		vc.logger.WithError(err).Warnf("Failed to list replicas of volume %v", v.Name)
+		return
+	}
+	healthyCount := 0
+	for _, r := range replicas {
+		if datastore.IsAvailableHealthyReplica(r) {
+			healthyCount++
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(vc.replicaCountMetric.Desc, vc.replicaCountMetric.Type, float64(len(replicas)), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
+	ch <- prometheus.MustNewConstMetric(vc.healthyReplicaCountMetric.Desc, vc.healthyReplicaCountMetric.Type, float64(healthyCount), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName)
+}
+
 func (vc *VolumeCollector) getEngineClientProxy(engine *longhorn.Engine) (c engineapi.EngineClientProxy, err error) {
 	engineCliClient, err := controller.GetBinaryClientForEngine(engine, &engineapi.EngineCollection{}, engine.Status.CurrentImage)
 	if err != nil {
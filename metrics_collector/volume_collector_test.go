@@ -0,0 +1,82 @@
+package metricscollector
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestVolumeCollectorLastBackupAge(c *C) {
+	tds := newTestDataStore()
+
+	backedUpVolume := newVolume("backed-up-volume", TestNode1)
+	backedUpVolume.Status.LastBackup = "backup-1"
+	backedUpVolume.Status.LastBackupAt = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	notBackedUpVolume := newVolume("not-backed-up-volume", TestNode1)
+	otherNodeVolume := newVolume("other-node-volume", TestNode2)
+	otherNodeVolume.Status.LastBackupAt = backedUpVolume.Status.LastBackupAt
+	tds.addVolume(c, backedUpVolume)
+	tds.addVolume(c, notBackedUpVolume)
+	tds.addVolume(c, otherNodeVolume)
+
+	vc := NewVolumeCollector(logrus.StandardLogger(), TestNode1, tds.ds)
+	metrics := collectMetricFamilies(c, vc)
+
+	c.Assert(metrics["longhorn_volume_state"].GetMetric(), HasLen, 2)
+	lastBackupAgeMetrics := metrics["longhorn_volume_last_backup_age_seconds"].GetMetric()
+	c.Assert(lastBackupAgeMetrics, HasLen, 1)
+	c.Assert(getMetricLabels(lastBackupAgeMetrics[0])[volumeLabel], Equals, backedUpVolume.Name)
+	age := lastBackupAgeMetrics[0].GetGauge().GetValue()
+	c.Assert(age >= time.Hour.Seconds(), Equals, true)
+	c.Assert(age < (time.Hour+time.Minute).Seconds(), Equals, true)
+}
+
+func (s *TestSuite) TestVolumeCollectorReplicaCount(c *C) {
+	tds := newTestDataStore()
+
+	v := newVolume(TestVolumeName, TestNode1)
+	tds.addVolume(c, v)
+	now := time.Now().UTC().Format(time.RFC3339)
+	deletingReplica := newReplicaForVolume(v, v.Name+"-r-4", now, "")
+	deletingReplica.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	for _, r := range []*longhorn.Replica{
+		newReplicaForVolume(v, v.Name+"-r-0", now, ""),
+		newReplicaForVolume(v, v.Name+"-r-1", now, ""),
+		// Rebuilding, failed and deleting replicas are not healthy
+		newReplicaForVolume(v, v.Name+"-r-2", "", ""),
+		newReplicaForVolume(v, v.Name+"-r-3", now, now),
+		deletingReplica,
+	} {
+		err := tds.rIndexer.Add(r)
+		c.Assert(err, IsNil)
+	}
+	noReplicaVolume := newVolume("no-replica-volume", TestNode1)
+	tds.addVolume(c, noReplicaVolume)
+
+	vc := NewVolumeCollector(logrus.StandardLogger(), TestNode1, tds.ds)
+	metrics := collectMetricFamilies(c, vc)
+
+	expected := map[string]map[string]float64{
+		"longhorn_volume_replica_count": {
+			v.Name:               5,
+			noReplicaVolume.Name: 0,
+		},
+		"longhorn_volume_healthy_replica_count": {
+			v.Name:               2,
+			noReplicaVolume.Name: 0,
+		},
+	}
+	for name, expectedValues := range expected {
+		values := map[string]float64{}
+		for _, m := range metrics[name].GetMetric() {
+			values[getMetricLabels(m)[volumeLabel]] = m.GetGauge().GetValue()
+		}
+		c.Assert(values, DeepEquals, expectedValues, Commentf("metric %v", name))
+	}
+}
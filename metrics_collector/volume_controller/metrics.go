@@ -0,0 +1,49 @@
+package volumecontroller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/longhorn/longhorn-manager/metrics_collector/registry"
+)
+
+// Package volumecontroller exposes the prometheus metrics reported by the
+// volume controller. The metrics are registered when the package is imported.
+
+// Metrics subsystem and keys used by the volume controller.
+const (
+	LonghornName            = "longhorn"
+	VolumeSubsystem         = "volume"
+	StaleReplicasDeletedKey = "stale_replicas_deleted_total"
+	VolumeLabel             = "volume"
+)
+
+var (
+	staleReplicasDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: LonghornName,
+		Subsystem: VolumeSubsystem,
+		Name:      StaleReplicasDeletedKey,
+		Help:      "Total number of stale replicas deleted by the volume controller",
+	}, []string{VolumeLabel})
+)
+
+func init() {
+	registry.Register(staleReplicasDeleted)
+}
+
+// MetricsRecorder records the volume controller events that are exposed as
+// metrics.
+type MetricsRecorder interface {
+	IncStaleReplicasDeleted(volumeName string)
+}
+
+type prometheusMetricsRecorder struct{}
+
+// NewMetricsRecorder returns a MetricsRecorder backed by the registered
+// prometheus metrics.
+func NewMetricsRecorder() MetricsRecorder {
+	return prometheusMetricsRecorder{}
+}
+
+func (prometheusMetricsRecorder) IncStaleReplicasDeleted(volumeName string) {
+	staleReplicasDeleted.WithLabelValues(volumeName).Inc()
+}
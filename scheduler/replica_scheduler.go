@@ -204,6 +204,13 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 		return map[string]*Disk{}, multiError
 	}
 
+	nodesReachingVolumeLimit, err := rcs.getNodesReachingVolumeLimit(volume)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get nodes reaching %v", types.SettingNameMaxVolumesPerNode)
+		multiError.Append(util.NewMultiError(err.Error()))
+		return map[string]*Disk{}, multiError
+	}
+
 	unusedNodes := map[string]*longhorn.Node{}
 	unusedNodesInUnusedZones := map[string]*longhorn.Node{}
 
@@ -218,6 +225,10 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 		if !types.IsSelectorsInTags(node.Spec.Tags, volume.Spec.NodeSelector, allowEmptyNodeSelectorVolume) {
 			continue
 		}
+		if nodesReachingVolumeLimit[nodeName] {
+			multiError.Append(util.NewMultiError(longhorn.ErrorReplicaScheduleNodeVolumeLimitReached))
+			continue
+		}
 		if _, ok := usedNodes[nodeName]; !ok {
 			unusedNodes[nodeName] = node
 		}
@@ -276,6 +287,51 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 	return map[string]*Disk{}, multiError
 }
 
+// getNodesReachingVolumeLimit returns the nodes that already have replicas of the number of distinct volumes limited
+// by the setting max-volumes-per-node. A node already having a replica of the volume is not counted as reaching the
+// limit, since another replica of the same volume doesn't increase its volume count.
+func (rcs *ReplicaScheduler) getNodesReachingVolumeLimit(volume *longhorn.Volume) (map[string]bool, error) {
+	nodesReachingLimit := map[string]bool{}
+
+	maxVolumesPerNode, err := rcs.ds.GetSettingAsInt(types.SettingNameMaxVolumesPerNode)
+	if err != nil {
+		return nil, err
+	}
+	if maxVolumesPerNode <= 0 {
+		return nodesReachingLimit, nil
+	}
+
+	replicas, err := rcs.ds.ListReplicasRO()
+	if err != nil {
+		return nil, err
+	}
+
+	for nodeName, volumes := range getNodeVolumes(replicas) {
+		if _, exists := volumes[volume.Name]; exists {
+			continue
+		}
+		if int64(len(volumes)) >= maxVolumesPerNode {
+			nodesReachingLimit[nodeName] = true
+		}
+	}
+	return nodesReachingLimit, nil
+}
+
+// getNodeVolumes returns the distinct volumes having replicas on each node
+func getNodeVolumes(replicas []*longhorn.Replica) map[string]map[string]struct{} {
+	nodeVolumes := map[string]map[string]struct{}{}
+	for _, r := range replicas {
+		if r.Spec.NodeID == "" {
+			continue
+		}
+		if nodeVolumes[r.Spec.NodeID] == nil {
+			nodeVolumes[r.Spec.NodeID] = map[string]struct{}{}
+		}
+		nodeVolumes[r.Spec.NodeID][r.Spec.VolumeName] = struct{}{}
+	}
+	return nodeVolumes
+}
+
 func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disks map[string]struct{}, replicas map[string]*longhorn.Replica, volume *longhorn.Volume, requireSchedulingCheck bool) (preferredDisks map[string]*Disk, multiError util.MultiError) {
 	multiError = util.NewMultiError()
 	preferredDisks = map[string]*Disk{}
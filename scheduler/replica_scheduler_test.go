@@ -206,6 +206,34 @@ func newReplicaForVolume(v *longhorn.Volume) *longhorn.Replica {
 	}
 }
 
+func newScheduledReplicaForVolume(v *longhorn.Volume, nodeID string) *longhorn.Replica {
+	r := newReplicaForVolume(v)
+	r.Spec.NodeID = nodeID
+	r.Spec.DiskID = getDiskID(nodeID, "1")
+	return r
+}
+
+// newSchedulableNode returns a schedulable node with one schedulable disk
+func newSchedulableNode(name, zone string) *longhorn.Node {
+	node := newNode(name, TestNamespace, zone, true, longhorn.ConditionStatusTrue)
+	node.Spec.Disks = map[string]longhorn.DiskSpec{
+		getDiskID(name, "1"): newDisk(TestDefaultDataPath, true, 0),
+	}
+	node.Status.DiskStatus = map[string]*longhorn.DiskStatus{
+		getDiskID(name, "1"): {
+			StorageAvailable: TestDiskAvailableSize,
+			StorageScheduled: 0,
+			StorageMaximum:   TestDiskSize,
+			Conditions: []longhorn.Condition{
+				newCondition(longhorn.DiskConditionTypeSchedulable, longhorn.ConditionStatusTrue),
+			},
+			DiskUUID: getDiskID(name, "1"),
+			Type:     longhorn.DiskTypeFilesystem,
+		},
+	}
+	return node
+}
+
 func getDiskID(nodeID, index string) string {
 	return fmt.Sprintf("%s-disk%s", nodeID, index)
 }
@@ -240,6 +268,10 @@ type ReplicaSchedulerTestCase struct {
 	replicaNodeSoftAntiAffinity       string
 	replicaZoneSoftAntiAffinity       string
 	replicaDiskSoftAntiAffinity       string
+	maxVolumesPerNode                 string
+
+	// replicas that are already scheduled, including the ones of other volumes
+	existingReplicas []*longhorn.Replica
 
 	// some test cases only try to schedule a subset of a volume's replicas
 	allReplicas        map[string]*longhorn.Replica
@@ -1097,6 +1129,114 @@ func (s *TestSuite) TestReplicaScheduler(c *C) {
 	tc.replicaZoneSoftAntiAffinity = "false" // Do not allow replicas to schedule to the same zone.
 	testCases["fail scheduling when doing so would reuse an invalid evicting node"] = tc
 
+	// Test skipping the node that already has replicas of the max number of distinct volumes
+	tc = generateSchedulerTestCase()
+	tc.daemons = []*corev1.Pod{
+		newDaemonPod(corev1.PodRunning, TestDaemon1, TestNamespace, TestNode1, TestIP1),
+		newDaemonPod(corev1.PodRunning, TestDaemon2, TestNamespace, TestNode2, TestIP2),
+		newDaemonPod(corev1.PodRunning, TestDaemon3, TestNamespace, TestNode3, TestIP3),
+	}
+	node1 = newSchedulableNode(TestNode1, TestZone1)
+	tc.engineImage.Status.NodeDeploymentMap[node1.Name] = true
+	node2 = newSchedulableNode(TestNode2, TestZone2)
+	tc.engineImage.Status.NodeDeploymentMap[node2.Name] = true
+	node3 = newSchedulableNode(TestNode3, TestZone1)
+	tc.engineImage.Status.NodeDeploymentMap[node3.Name] = true
+	tc.nodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+		TestNode2: node2,
+		TestNode3: node3,
+	}
+	tc.existingReplicas = []*longhorn.Replica{
+		newScheduledReplicaForVolume(newVolume("other-volume", 1), TestNode1),
+	}
+	tc.expectedNodes = map[string]*longhorn.Node{
+		TestNode2: node2,
+		TestNode3: node3,
+	}
+	tc.err = false
+	tc.firstNilReplica = -1
+	tc.maxVolumesPerNode = "1"
+	testCases["skip node reaching max volumes per node"] = tc
+
+	// Test failing scheduling when all nodes have replicas of the max number of distinct volumes
+	tc = generateSchedulerTestCase()
+	tc.daemons = []*corev1.Pod{
+		newDaemonPod(corev1.PodRunning, TestDaemon1, TestNamespace, TestNode1, TestIP1),
+		newDaemonPod(corev1.PodRunning, TestDaemon2, TestNamespace, TestNode2, TestIP2),
+	}
+	node1 = newSchedulableNode(TestNode1, TestZone1)
+	tc.engineImage.Status.NodeDeploymentMap[node1.Name] = true
+	node2 = newSchedulableNode(TestNode2, TestZone2)
+	tc.engineImage.Status.NodeDeploymentMap[node2.Name] = true
+	tc.nodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+		TestNode2: node2,
+	}
+	tc.existingReplicas = []*longhorn.Replica{
+		newScheduledReplicaForVolume(newVolume("other-volume-1", 2), TestNode1),
+		newScheduledReplicaForVolume(newVolume("other-volume-1", 2), TestNode2),
+		newScheduledReplicaForVolume(newVolume("other-volume-2", 1), TestNode2),
+	}
+	tc.expectedNodes = map[string]*longhorn.Node{}
+	tc.err = false
+	tc.firstNilReplica = 0
+	tc.maxVolumesPerNode = "1"
+	testCases["fail scheduling when all nodes reach max volumes per node"] = tc
+
+	// Test scheduling an evicting replica to another disk of a node reaching max volumes per node, since the node
+	// already has a replica of the volume
+	tc = generateSchedulerTestCase()
+	tc.daemons = []*corev1.Pod{
+		newDaemonPod(corev1.PodRunning, TestDaemon1, TestNamespace, TestNode1, TestIP1),
+	}
+	evictingReplica := newScheduledReplicaForVolume(tc.volume, TestNode1)
+	evictingReplica.Status.EvictionRequested = true
+	tc.allReplicas[evictingReplica.Name] = evictingReplica
+	node1 = newSchedulableNode(TestNode1, TestZone1)
+	node1.Spec.Disks[getDiskID(TestNode1, "2")] = newDisk(TestDefaultDataPath, true, 0)
+	node1.Status.DiskStatus[getDiskID(TestNode1, "1")].StorageScheduled = TestVolumeSize
+	node1.Status.DiskStatus[getDiskID(TestNode1, "1")].ScheduledReplica = map[string]int64{evictingReplica.Name: TestVolumeSize}
+	node1.Status.DiskStatus[getDiskID(TestNode1, "2")] = &longhorn.DiskStatus{
+		StorageAvailable: TestDiskAvailableSize,
+		StorageScheduled: 0,
+		StorageMaximum:   TestDiskSize,
+		Conditions: []longhorn.Condition{
+			newCondition(longhorn.DiskConditionTypeSchedulable, longhorn.ConditionStatusTrue),
+		},
+		DiskUUID: getDiskID(TestNode1, "2"),
+		Type:     longhorn.DiskTypeFilesystem,
+	}
+	tc.engineImage.Status.NodeDeploymentMap[node1.Name] = true
+	tc.nodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+	}
+	tc.existingReplicas = []*longhorn.Replica{
+		evictingReplica,
+		newScheduledReplicaForVolume(newVolume("other-volume", 1), TestNode1),
+	}
+	// Only schedule one of the volume's unscheduled replicas
+	tc.replicasToSchedule = map[string]struct{}{}
+	for name, r := range tc.allReplicas {
+		if r.Spec.NodeID == "" {
+			tc.replicasToSchedule[name] = struct{}{}
+			break
+		}
+	}
+	tc.expectedNodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+	}
+	tc.expectedDisks = map[string]struct{}{
+		getDiskID(TestNode1, "2"): {},
+	}
+	tc.err = false
+	tc.firstNilReplica = -1
+	tc.replicaNodeSoftAntiAffinity = "false" // Do not allow replicas to schedule to the same node unless evicting.
+	tc.replicaZoneSoftAntiAffinity = "true"
+	tc.replicaDiskSoftAntiAffinity = "false" // Do not allow replicas to schedule to the same disk.
+	tc.maxVolumesPerNode = "2"
+	testCases["schedule evicting replica to another disk of node reaching max volumes per node"] = tc
+
 	for name, tc := range testCases {
 		fmt.Printf("testing %v\n", name)
 
@@ -1141,6 +1281,13 @@ func (s *TestSuite) TestReplicaScheduler(c *C) {
 		c.Assert(volume, NotNil)
 		err = vIndexer.Add(volume)
 		c.Assert(err, IsNil)
+		// create replicas of other volumes
+		for _, replica := range tc.existingReplicas {
+			r, err := lhClient.LonghornV1beta2().Replicas(TestNamespace).Create(context.TODO(), replica, metav1.CreateOptions{})
+			c.Assert(err, IsNil)
+			err = rIndexer.Add(r)
+			c.Assert(err, IsNil)
+		}
 		// set settings
 		setSettings(tc, lhClient, sIndexer, c)
 		// validate scheduler
@@ -1236,6 +1383,17 @@ func setSettings(tc *ReplicaSchedulerTestCase, lhClient *lhfake.Clientset, sInde
 		err = sIndexer.Add(setting)
 		c.Assert(err, IsNil)
 	}
+	// Set max volumes per node setting
+	if tc.maxVolumesPerNode != "" {
+		s := initSettings(
+			string(types.SettingNameMaxVolumesPerNode),
+			tc.maxVolumesPerNode)
+		setting, err :=
+			lhClient.LonghornV1beta2().Settings(TestNamespace).Create(context.TODO(), s, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		err = sIndexer.Add(setting)
+		c.Assert(err, IsNil)
+	}
 }
 
 func (s *TestSuite) TestFilterDisksWithMatchingReplicas(c *C) {
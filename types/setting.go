@@ -112,6 +112,7 @@ const (
 	SettingNameReplicaDiskSoftAntiAffinity                              = SettingName("replica-disk-soft-anti-affinity")
 	SettingNameAllowEmptyNodeSelectorVolume                             = SettingName("allow-empty-node-selector-volume")
 	SettingNameAllowEmptyDiskSelectorVolume                             = SettingName("allow-empty-disk-selector-volume")
+	SettingNameMaxVolumesPerNode                                        = SettingName("max-volumes-per-node")
 )
 
 var (
@@ -187,6 +188,7 @@ var (
 		SettingNameReplicaDiskSoftAntiAffinity,
 		SettingNameAllowEmptyNodeSelectorVolume,
 		SettingNameAllowEmptyDiskSelectorVolume,
+		SettingNameMaxVolumesPerNode,
 	}
 )
 
@@ -288,6 +290,7 @@ var (
 		SettingNameReplicaDiskSoftAntiAffinity:                              SettingDefinitionReplicaDiskSoftAntiAffinity,
 		SettingNameAllowEmptyNodeSelectorVolume:                             SettingDefinitionAllowEmptyNodeSelectorVolume,
 		SettingNameAllowEmptyDiskSelectorVolume:                             SettingDefinitionAllowEmptyDiskSelectorVolume,
+		SettingNameMaxVolumesPerNode:                                        SettingDefinitionMaxVolumesPerNode,
 	}
 
 	SettingDefinitionBackupTarget = SettingDefinition{
@@ -1144,6 +1147,18 @@ var (
 		ReadOnly:    false,
 		Default:     "true",
 	}
+
+	SettingDefinitionMaxVolumesPerNode = SettingDefinition{
+		DisplayName: "Max Volumes Per Node",
+		Description: "The maximum number of distinct volumes that can have replicas on a node. " +
+			"A node hosting replicas of this many volumes is skipped when scheduling a replica of another volume, which limits the blast radius of a node failure. \n\n" +
+			"Different from the replica count, replicas of the same volume are counted once. When the value is 0, there is no limit.",
+		Category: SettingCategoryScheduling,
+		Type:     SettingTypeInt,
+		Required: true,
+		ReadOnly: false,
+		Default:  "0",
+	}
 )
 
 type NodeDownPodDeletionPolicy string
@@ -1325,6 +1340,8 @@ func ValidateSetting(name, value string) (err error) {
 		fallthrough
 	case SettingNameFailedBackupTTL:
 		fallthrough
+	case SettingNameMaxVolumesPerNode:
+		fallthrough
 	case SettingNameV2DataEngineHugepageLimit:
 		value, err := strconv.Atoi(value)
 		if err != nil {
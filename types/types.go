@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -727,6 +728,22 @@ func ValidateReplicaCount(count int) error {
 	return nil
 }
 
+func ValidateStaleReplicaTimeout(timeout int) error {
+	if timeout < 0 {
+		return fmt.Errorf("stale replica timeout value must not be negative")
+	}
+	return nil
+}
+
+// GetStaleReplicaTimeout returns the stale replica timeout of the volume, which
+// is stored in minutes. Zero means failed replicas are never cleaned up.
+func GetStaleReplicaTimeout(v *longhorn.Volume) time.Duration {
+	if v.Spec.StaleReplicaTimeout <= 0 {
+		return 0
+	}
+	return time.Duration(v.Spec.StaleReplicaTimeout) * time.Minute
+}
+
 func ValidateLogLevel(level string) error {
 	if _, err := logrus.ParseLevel(level); err != nil {
 		return fmt.Errorf("log level is invalid")
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
 
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -233,3 +236,40 @@ func (s *TestSuite) TestGenerateEngineNameForVolume(c *C) {
 		c.Assert(actual, Equals, testCase.expectedEngineName, Commentf(TestErrResultFmt, testName))
 	}
 }
+
+func (s *TestSuite) TestValidateStaleReplicaTimeout(c *C) {
+	c.Assert(ValidateStaleReplicaTimeout(0), IsNil)
+	c.Assert(ValidateStaleReplicaTimeout(2880), IsNil)
+	c.Assert(ValidateStaleReplicaTimeout(-1), NotNil)
+}
+
+func (s *TestSuite) TestGetStaleReplicaTimeout(c *C) {
+	testCases := map[string]struct {
+		staleReplicaTimeout int
+		expected            time.Duration
+	}{
+		"default timeout": {
+			staleReplicaTimeout: 2880,
+			expected:            48 * time.Hour,
+		},
+		"zero timeout disables cleanup": {
+			staleReplicaTimeout: 0,
+			expected:            0,
+		},
+		"negative timeout disables cleanup": {
+			staleReplicaTimeout: -1,
+			expected:            0,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		v := &longhorn.Volume{
+			Spec: longhorn.VolumeSpec{
+				StaleReplicaTimeout: testCase.staleReplicaTimeout,
+			},
+		}
+		c.Assert(GetStaleReplicaTimeout(v), Equals, testCase.expected, Commentf(TestErrResultFmt, testName))
+	}
+}
@@ -73,6 +73,10 @@ func (v *volumeValidator) Create(request *admission.Request, newObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "")
 	}
 
+	if err := types.ValidateStaleReplicaTimeout(volume.Spec.StaleReplicaTimeout); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	if err := types.ValidateUnmapMarkSnapChainRemoved(volume.Spec.UnmapMarkSnapChainRemoved); err != nil {
 		return werror.NewInvalidError(err.Error(), "")
 	}
@@ -165,6 +169,12 @@ func (v *volumeValidator) Update(request *admission.Request, oldObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "")
 	}
 
+	if oldVolume.Spec.StaleReplicaTimeout != newVolume.Spec.StaleReplicaTimeout {
+		if err := types.ValidateStaleReplicaTimeout(newVolume.Spec.StaleReplicaTimeout); err != nil {
+			return werror.NewInvalidError(err.Error(), "")
+		}
+	}
+
 	if err := types.ValidateUnmapMarkSnapChainRemoved(newVolume.Spec.UnmapMarkSnapChainRemoved); err != nil {
 		return werror.NewInvalidError(err.Error(), "")
 	}
@@ -0,0 +1,37 @@
+package volume
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TestSuite struct {
+}
+
+var _ = Suite(&TestSuite{})
+
+func (s *TestSuite) TestCreateRejectsNegativeStaleReplicaTimeout(c *C) {
+	volume := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-volume",
+		},
+		Spec: longhorn.VolumeSpec{
+			NumberOfReplicas:    3,
+			DataLocality:        longhorn.DataLocalityDisabled,
+			AccessMode:          longhorn.AccessModeReadWriteOnce,
+			ReplicaAutoBalance:  longhorn.ReplicaAutoBalanceIgnored,
+			StaleReplicaTimeout: -1,
+		},
+	}
+
+	// The timeout is validated before the datastore is used
+	err := NewValidator(nil, "").Create(nil, volume)
+	c.Assert(err, ErrorMatches, ".*stale replica timeout value must not be negative.*")
+}